@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -19,6 +20,7 @@ import (
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
 	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
 )
 
 // ChainID is the EIP-155 replay-protection chain id for the current ethereum chain config.
@@ -89,6 +91,83 @@ func (b *Backend) BaseFee(blockRes *cmtrpctypes.ResultBlockResults) (*big.Int, e
 	return res.BaseFee.BigInt(), nil
 }
 
+// baseFeePerHeightConcurrency bounds the number of heights BaseFeePerHeight
+// queries in parallel.
+const baseFeePerHeightConcurrency = 8
+
+// BaseFeePerHeight returns the base fees for the inclusive height range
+// [start, end], indexed from start. Heights are queried concurrently, bounded
+// by baseFeePerHeightConcurrency.
+//
+// Heights whose block results are unavailable (pruned, not yet committed or
+// not persisted by the node) get a nil entry, as do heights with no base fee
+// tracked or whose base fee can't be queried (e.g. pruned state). Any other
+// block results error fails the whole range.
+func (b *Backend) BaseFeePerHeight(start, end int64) ([]*big.Int, error) {
+	if start < 1 || end < start {
+		return nil, fmt.Errorf("invalid height range [%d, %d]", start, end)
+	}
+
+	// This helper backs fee history style range queries, so it shares the
+	// eth_feeHistory block cap instead of introducing a separate limit.
+	count := end - start + 1
+	maxBlockCount := int64(b.cfg.JSONRPC.FeeHistoryCap)
+	if count > maxBlockCount {
+		return nil, fmt.Errorf("BaseFeePerHeight block count %d higher than %d", count, maxBlockCount)
+	}
+
+	// each goroutine only writes to its own index of baseFees
+	baseFees := make([]*big.Int, count)
+
+	// stop scheduling and querying the remaining heights once a goroutine
+	// fails or the backend context is done
+	g, gctx := errgroup.WithContext(b.ctx)
+	g.SetLimit(baseFeePerHeightConcurrency)
+
+	for height := start; height <= end && gctx.Err() == nil; height++ {
+		g.Go(func() error {
+			if err := gctx.Err(); err != nil {
+				return err
+			}
+
+			index := height - start
+
+			blockRes, err := b.TendermintBlockResultByNumber(&height)
+			if err != nil {
+				if !isHeightUnavailableErr(err) {
+					return errorsmod.Wrapf(err, "failed to fetch block results for height %d", height)
+				}
+				b.logger.Debug("block result not available", "height", height, "error", err.Error())
+				return nil
+			}
+			if blockRes == nil {
+				b.logger.Debug("block result not found", "height", height)
+				return nil
+			}
+
+			baseFee, err := b.BaseFee(blockRes)
+			if err != nil {
+				// tolerate the error for pruned node.
+				b.logger.Debug("fetch basefee failed, node is pruned?", "height", height, "error", err.Error())
+				return nil
+			}
+
+			baseFees[index] = baseFee
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	// the loop may have stopped early without any goroutine failing
+	if err := b.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return baseFees, nil
+}
+
 // CurrentHeader returns the latest block header
 // This will return error as per node configuration
 // if the ABCI responses are discarded ('discard_abci_responses' config param)
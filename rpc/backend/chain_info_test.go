@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 
@@ -8,9 +9,11 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 
+	mock "github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/metadata"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/cometbft/cometbft/abci/types"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -153,6 +156,171 @@ func (suite *BackendTestSuite) TestBaseFee() {
 	}
 }
 
+func (suite *BackendTestSuite) TestBaseFeePerHeight() {
+	baseFee := math.NewInt(1)
+	prunedErr := fmt.Errorf("height 2 is not available, lowest height is 3")
+
+	// registerBlockResults mocks the block results for a single height, or the
+	// given error when err is not nil. BlockResults is queried with the backend
+	// context, not a per-height one.
+	registerBlockResults := func(height int64, err error) *mock.Call {
+		client := suite.backend.clientCtx.Client.(*mocks.Client)
+		matchHeight := mock.MatchedBy(func(h *int64) bool { return h != nil && *h == height })
+		if err != nil {
+			return client.On("BlockResults", suite.backend.ctx, matchHeight).Return(nil, err)
+		}
+		return client.On("BlockResults", suite.backend.ctx, matchHeight).
+			Return(&tmrpctypes.ResultBlockResults{Height: height}, nil)
+	}
+
+	// registerBaseFee mocks the base fee query for a single height, or the
+	// given error when err is not nil. A nil baseFee and err mock a disabled
+	// base fee.
+	registerBaseFee := func(height int64, baseFee *math.Int, err error) *mock.Call {
+		queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+		if err != nil {
+			return queryClient.On("BaseFee", rpc.ContextWithHeight(height), &evmtypes.QueryBaseFeeRequest{}).
+				Return(&evmtypes.QueryBaseFeeResponse{}, err)
+		}
+		return queryClient.On("BaseFee", rpc.ContextWithHeight(height), &evmtypes.QueryBaseFeeRequest{}).
+			Return(&evmtypes.QueryBaseFeeResponse{BaseFee: baseFee}, nil)
+	}
+
+	testCases := []struct {
+		name         string
+		start        int64
+		end          int64
+		registerMock func()
+		expBaseFees  []*big.Int
+		expPass      bool
+	}{
+		{
+			"fail - start height below 1",
+			0,
+			1,
+			func() {},
+			nil,
+			false,
+		},
+		{
+			"fail - end height before start height",
+			2,
+			1,
+			func() {},
+			nil,
+			false,
+		},
+		{
+			"fail - block count higher than fee history cap",
+			1,
+			3,
+			func() {
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - block results error other than an unavailable height",
+			1,
+			3,
+			func() {
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 3
+				// heights are queried concurrently, so the other heights may not be reached
+				registerBlockResults(1, nil).Maybe()
+				registerBaseFee(1, &baseFee, nil).Maybe()
+				registerBlockResults(2, errortypes.ErrInvalidRequest)
+				registerBlockResults(3, nil).Maybe()
+				registerBaseFee(3, &baseFee, nil).Maybe()
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - backend context cancelled",
+			1,
+			2,
+			func() {
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				ctx, cancel := context.WithCancel(suite.backend.ctx)
+				cancel()
+				suite.backend.ctx = ctx
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - every height unavailable returns nil entries",
+			1,
+			2,
+			func() {
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				registerBlockResults(1, prunedErr)
+				registerBlockResults(2, nil)
+				registerBaseFee(2, nil, evmtypes.ErrInvalidBaseFee)
+			},
+			[]*big.Int{nil, nil},
+			true,
+		},
+		{
+			"pass - pruned height returns nil entry",
+			1,
+			3,
+			func() {
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 3
+				registerBlockResults(1, nil)
+				registerBaseFee(1, &baseFee, nil)
+				registerBlockResults(2, prunedErr)
+				registerBlockResults(3, nil)
+				registerBaseFee(3, &baseFee, nil)
+			},
+			[]*big.Int{baseFee.BigInt(), nil, baseFee.BigInt()},
+			true,
+		},
+		{
+			"pass - grpc BaseFee error without feemarket block event returns nil entry",
+			1,
+			2,
+			func() {
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				registerBlockResults(1, nil)
+				registerBaseFee(1, nil, evmtypes.ErrInvalidBaseFee)
+				registerBlockResults(2, nil)
+				registerBaseFee(2, &baseFee, nil)
+			},
+			[]*big.Int{nil, baseFee.BigInt()},
+			true,
+		},
+		{
+			"pass - base fee not enabled returns nil entry",
+			1,
+			1,
+			func() {
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 1
+				registerBlockResults(1, nil)
+				registerBaseFee(1, nil, nil)
+			},
+			[]*big.Int{nil},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			baseFees, err := suite.backend.BaseFeePerHeight(tc.start, tc.end)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expBaseFees, baseFees)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestChainId() {
 	expChainID := (*hexutil.Big)(big.NewInt(9000))
 	testCases := []struct {
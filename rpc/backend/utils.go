@@ -44,6 +44,19 @@ func (s sortGasAndReward) Less(i, j int) bool {
 	return s[i].reward.Cmp(s[j].reward) < 0
 }
 
+// isHeightUnavailableErr returns true if the error returned by a CometBFT
+// BlockResults query means the results for the requested height are not
+// available on this node: the block is pruned, not yet committed, or the node
+// doesn't persist finalize block responses. The errors are matched by message
+// since they lose their type when returned over RPC.
+func isHeightUnavailableErr(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "is not available, lowest height is") ||
+		strings.Contains(msg, "must be less than or equal to the current blockchain height") ||
+		strings.Contains(msg, "could not find results for height") ||
+		strings.Contains(msg, "node is not persisting finalize block responses")
+}
+
 // getAccountNonce returns the account nonce for the given account address.
 // If the pending value is true, it will iterate over the mempool (pending)
 // txs in order to compute and return the pending tx sequence.